@@ -0,0 +1,139 @@
+package v1alpha1
+
+import (
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultPlugin identifies a Velero plugin the operator knows how to install
+// via an init container on the Velero deployment.
+type DefaultPlugin string
+
+const (
+	DefaultPluginOpenShift      DefaultPlugin = "openshift"
+	DefaultPluginAWS            DefaultPlugin = "aws"
+	DefaultPluginGCP            DefaultPlugin = "gcp"
+	DefaultPluginMicrosoftAzure DefaultPlugin = "azure"
+	DefaultPluginCSI            DefaultPlugin = "csi"
+)
+
+// UploaderType selects which pod-volume uploader Velero server runs with.
+// +kubebuilder:validation:Enum=restic;kopia
+type UploaderType string
+
+const (
+	UploaderTypeRestic UploaderType = "restic"
+	UploaderTypeKopia  UploaderType = "kopia"
+)
+
+// PodVolumeBackupMode selects whether pod volumes are backed up only when
+// explicitly annotated (opt-in) or backed up by default unless excluded
+// (opt-out).
+// +kubebuilder:validation:Enum=opt-in;opt-out
+type PodVolumeBackupMode string
+
+const (
+	PodVolumeBackupModeOptIn  PodVolumeBackupMode = "opt-in"
+	PodVolumeBackupModeOptOut PodVolumeBackupMode = "opt-out"
+)
+
+// PodResourceRequirements mirrors corev1.ResourceRequirements as plain CPU
+// and memory quantity strings, for use directly on the CRD.
+type PodResourceRequirements struct {
+	CPURequest    string `json:"cpuRequest,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	CPULimit      string `json:"cpuLimit,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty"`
+}
+
+// MaintenanceConfig configures the periodic repository maintenance
+// (prune/check) Job the operator creates for each BackupRepository.
+type MaintenanceConfig struct {
+	// KeepLatestMaintenanceJobs caps how many completed maintenance Jobs are
+	// retained per BackupRepository.
+	// +optional
+	KeepLatestMaintenanceJobs int `json:"keepLatestMaintenanceJobs,omitempty"`
+
+	// PodResources sets the CPU/memory requests and limits for the
+	// maintenance Job's pod.
+	// +optional
+	PodResources PodResourceRequirements `json:"podResources,omitempty"`
+
+	// FrequencyMinutes overrides how often maintenance runs per
+	// BackupRepository. Defaults to Velero's built-in frequency when unset.
+	// +optional
+	FrequencyMinutes *int64 `json:"frequencyMinutes,omitempty"`
+}
+
+// VeleroSpec defines the desired state of a Velero installation.
+type VeleroSpec struct {
+	// EnableRestic deploys the restic/node-agent DaemonSet alongside Velero.
+	// +optional
+	EnableRestic *bool `json:"enableRestic,omitempty"`
+
+	// EnableCSI installs the Velero CSI plugin and enables CSI snapshotting.
+	// +optional
+	EnableCSI *bool `json:"enableCSI,omitempty"`
+
+	// UploaderType selects the pod-volume uploader (restic or kopia).
+	// Defaults to restic for backward compatibility.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
+
+	// PodVolumeBackupMode selects opt-in or opt-out pod-volume backup.
+	// Defaults to opt-in.
+	// +optional
+	PodVolumeBackupMode PodVolumeBackupMode `json:"podVolumeBackupMode,omitempty"`
+
+	// MaintenanceConfig configures the periodic BackupRepository
+	// maintenance Job.
+	// +optional
+	MaintenanceConfig MaintenanceConfig `json:"maintenanceConfig,omitempty"`
+
+	// BackupStorageLocations lists the object storage locations Velero can
+	// read/write backups to.
+	BackupStorageLocations []velero.BackupStorageLocationSpec `json:"backupStorageLocations,omitempty"`
+
+	// VolumeSnapshotLocations lists the volume snapshot providers Velero can
+	// use to take native volume snapshots.
+	// +optional
+	VolumeSnapshotLocations []velero.VolumeSnapshotLocationSpec `json:"volumeSnapshotLocations,omitempty"`
+
+	// DefaultVeleroPlugins lists the plugins the operator installs as init
+	// containers on the Velero deployment.
+	DefaultVeleroPlugins []DefaultPlugin `json:"defaultVeleroPlugins,omitempty"`
+}
+
+// VeleroStatus defines the observed state of a Velero installation.
+type VeleroStatus struct {
+	// Conditions represent the latest available observations of the
+	// Velero installation's state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Velero is the Schema for the velero API, describing a desired Velero
+// installation managed by the operator.
+type Velero struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VeleroSpec   `json:"spec,omitempty"`
+	Status VeleroStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VeleroList contains a list of Velero installations.
+type VeleroList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Velero `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Velero{}, &VeleroList{})
+}
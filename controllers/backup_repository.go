@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupRepositoryTypeForUploader returns the BackupRepository.Spec.RepositoryType
+// the operator creates for pod-volume backups, matching whichever uploader
+// the Velero CR is configured with.
+func BackupRepositoryTypeForUploader(v *oadpv1alpha1.Velero) string {
+	if uploaderTypeOrDefault(v) == oadpv1alpha1.UploaderTypeKopia {
+		return velero.BackupRepositoryTypeKopia
+	}
+	return velero.BackupRepositoryTypeRestic
+}
@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// bslNameForIndex derives the Kubernetes object name the operator assigns
+// to the BackupStorageLocation it creates for Spec.BackupStorageLocations[i].
+// Callers (including e2e tests) must not assume this matches any label the
+// caller used to build the spec; look the live object up by its
+// provider/bucket/prefix instead of guessing the name.
+func bslNameForIndex(v *oadpv1alpha1.Velero, i int) string {
+	return fmt.Sprintf("%s-%d", v.Name, i)
+}
+
+// ReconcileBackupStorageLocations ensures a BackupStorageLocation exists for
+// every entry in Spec.BackupStorageLocations, creating any that are missing.
+func ReconcileBackupStorageLocations(ctx context.Context, c client.Client, v *oadpv1alpha1.Velero) error {
+	for i, spec := range v.Spec.BackupStorageLocations {
+		bsl := &velero.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bslNameForIndex(v, i),
+				Namespace: v.Namespace,
+			},
+			Spec: spec,
+		}
+		if err := controllerutil.SetControllerReference(v, bsl, c.Scheme()); err != nil {
+			return err
+		}
+		if err := c.Create(ctx, bsl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// maintenanceJobLabelSelector labels the Jobs this controller creates so
+// e2e and monitoring can find them without depending on naming.
+const maintenanceJobLabelSelector = "velero.io/repository-maintenance-job"
+
+// defaultMaintenanceFrequencyMinutes is used when MaintenanceConfig doesn't
+// override the maintenance frequency.
+const defaultMaintenanceFrequencyMinutes = 60
+
+// ReconcileMaintenanceJobs ensures a repository maintenance Job exists for
+// every BackupRepository owned by this Velero instance, running
+// prune/check so long-running maintenance doesn't compete with the Velero
+// server pod for memory.
+func ReconcileMaintenanceJobs(ctx context.Context, c client.Client, v *oadpv1alpha1.Velero, repos []velero.BackupRepository) error {
+	for _, repo := range repos {
+		job := buildMaintenanceJob(v, repo)
+		if err := controllerutil.SetControllerReference(v, job, c.Scheme()); err != nil {
+			return err
+		}
+		if err := c.Create(ctx, job); err != nil {
+			return err
+		}
+	}
+	return pruneOldMaintenanceJobs(ctx, c, v)
+}
+
+// buildMaintenanceJob constructs the Job that runs `velero repo maintenance`
+// against a single BackupRepository.
+func buildMaintenanceJob(v *oadpv1alpha1.Velero, repo velero.BackupRepository) *batchv1.Job {
+	cfg := v.Spec.MaintenanceConfig
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-maintain-", repo.Name),
+			Namespace:    v.Namespace,
+			Labels: map[string]string{
+				maintenanceJobLabelSelector: "true",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:      "maintenance",
+							Image:     "velero/velero",
+							Command:   []string{"/velero"},
+							Args:      []string{"repo-maintenance", "--repo-name=" + repo.Name},
+							Resources: maintenanceResourceRequirements(cfg.PodResources),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// maintenanceResourceRequirements converts the CRD's plain-string
+// CPU/memory fields into a corev1.ResourceRequirements, leaving any unset
+// quantity out so the cluster default (or no limit) applies.
+func maintenanceResourceRequirements(cfg oadpv1alpha1.PodResourceRequirements) corev1.ResourceRequirements {
+	requirements := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+	}
+	addQuantity(requirements.Requests, corev1.ResourceCPU, cfg.CPURequest)
+	addQuantity(requirements.Requests, corev1.ResourceMemory, cfg.MemoryRequest)
+	addQuantity(requirements.Limits, corev1.ResourceCPU, cfg.CPULimit)
+	addQuantity(requirements.Limits, corev1.ResourceMemory, cfg.MemoryLimit)
+	return requirements
+}
+
+func addQuantity(list corev1.ResourceList, name corev1.ResourceName, value string) {
+	if value == "" {
+		return
+	}
+	if qty, err := resource.ParseQuantity(value); err == nil {
+		list[name] = qty
+	}
+}
+
+// pruneOldMaintenanceJobs deletes completed maintenance Jobs beyond
+// KeepLatestMaintenanceJobs, oldest first.
+func pruneOldMaintenanceJobs(ctx context.Context, c client.Client, v *oadpv1alpha1.Velero) error {
+	keep := v.Spec.MaintenanceConfig.KeepLatestMaintenanceJobs
+	if keep <= 0 {
+		return nil
+	}
+
+	jobs := batchv1.JobList{}
+	if err := c.List(ctx, &jobs, client.InNamespace(v.Namespace), client.MatchingLabels{maintenanceJobLabelSelector: "true"}); err != nil {
+		return err
+	}
+	completed := make([]batchv1.Job, 0, len(jobs.Items))
+	for _, job := range jobs.Items {
+		if job.Status.Succeeded > 0 || job.Status.Failed > 0 {
+			completed = append(completed, job)
+		}
+	}
+	if len(completed) <= keep {
+		return nil
+	}
+	for _, job := range completed[:len(completed)-keep] {
+		job := job
+		if err := c.Delete(ctx, &job); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"fmt"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// pluginImages maps each DefaultPlugin to the init container image the
+// operator installs it from.
+var pluginImages = map[oadpv1alpha1.DefaultPlugin]string{
+	oadpv1alpha1.DefaultPluginOpenShift:      "quay.io/konveyor/openshift-velero-plugin:latest",
+	oadpv1alpha1.DefaultPluginAWS:            "velero/velero-plugin-for-aws:latest",
+	oadpv1alpha1.DefaultPluginGCP:            "velero/velero-plugin-for-gcp:latest",
+	oadpv1alpha1.DefaultPluginMicrosoftAzure: "velero/velero-plugin-for-microsoft-azure:latest",
+	oadpv1alpha1.DefaultPluginCSI:            "velero/velero-plugin-for-csi:latest",
+}
+
+// BuildPluginInitContainers returns one init container per requested
+// DefaultPlugin, so a Velero instance with several provider BSLs (e.g. AWS
+// and GCP) gets every plugin it needs installed in a single deployment.
+func BuildPluginInitContainers(plugins []oadpv1alpha1.DefaultPlugin) ([]corev1.Container, error) {
+	containers := make([]corev1.Container, 0, len(plugins))
+	for _, plugin := range plugins {
+		image, ok := pluginImages[plugin]
+		if !ok {
+			return nil, fmt.Errorf("unknown Velero plugin %q", plugin)
+		}
+		containers = append(containers, corev1.Container{
+			Name:  string(plugin),
+			Image: image,
+		})
+	}
+	return containers, nil
+}
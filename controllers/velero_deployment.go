@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"fmt"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+)
+
+// uploaderTypeOrDefault returns the configured UploaderType, defaulting to
+// restic for backward compatibility with CRs that predate this field.
+func uploaderTypeOrDefault(v *oadpv1alpha1.Velero) oadpv1alpha1.UploaderType {
+	if v.Spec.UploaderType == "" {
+		return oadpv1alpha1.UploaderTypeRestic
+	}
+	return v.Spec.UploaderType
+}
+
+// BuildVeleroDeploymentArgs returns the command-line arguments the Velero
+// server container is reconciled with, derived from the Velero CR.
+func BuildVeleroDeploymentArgs(v *oadpv1alpha1.Velero) []string {
+	args := []string{"server"}
+	args = append(args, fmt.Sprintf("--uploader-type=%s", uploaderTypeOrDefault(v)))
+	if v.Spec.EnableCSI != nil && *v.Spec.EnableCSI {
+		args = append(args, "--features=EnableCSI")
+	}
+	args = append(args, fmt.Sprintf("--default-volumes-to-fs-backup=%t", v.Spec.PodVolumeBackupMode == oadpv1alpha1.PodVolumeBackupModeOptOut))
+	return args
+}
+
+// PluginsForVelero returns the DefaultVeleroPlugins to install for a Velero
+// CR, adding the CSI plugin on top of whatever the CR already requests when
+// EnableCSI is set, so enabling CSI never requires the caller to also
+// remember to list the plugin.
+func PluginsForVelero(v *oadpv1alpha1.Velero) []oadpv1alpha1.DefaultPlugin {
+	plugins := append([]oadpv1alpha1.DefaultPlugin{}, v.Spec.DefaultVeleroPlugins...)
+	if v.Spec.EnableCSI == nil || !*v.Spec.EnableCSI {
+		return plugins
+	}
+	for _, plugin := range plugins {
+		if plugin == oadpv1alpha1.DefaultPluginCSI {
+			return plugins
+		}
+	}
+	return append(plugins, oadpv1alpha1.DefaultPluginCSI)
+}
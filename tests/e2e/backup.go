@@ -0,0 +1,62 @@
+package e2e
+
+import (
+	"context"
+
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// createBackupForBSL creates a Backup targeting a specific
+// BackupStorageLocation by name, so a single Velero instance with multiple
+// BSLs can be exercised against each of them in turn.
+func createBackupForBSL(namespace, backupName, bslName string, includedNamespaces []string) error {
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		return err
+	}
+	velero.AddToScheme(c.Scheme())
+
+	backup := &velero.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName,
+			Namespace: namespace,
+		},
+		Spec: velero.BackupSpec{
+			StorageLocation:    bslName,
+			IncludedNamespaces: includedNamespaces,
+		},
+	}
+	return c.Create(context.Background(), backup)
+}
+
+// backupPhase waits for a Backup to reach one of the given terminal phases.
+func backupPhase(namespace, backupName string, phases ...velero.BackupPhase) wait.ConditionFunc {
+	return func() (bool, error) {
+		c, err := client.New(config.GetConfigOrDie(), client.Options{})
+		if err != nil {
+			return false, err
+		}
+		velero.AddToScheme(c.Scheme())
+
+		backup := velero.Backup{}
+		err = c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: backupName}, &backup)
+		if err != nil {
+			return false, err
+		}
+		for _, phase := range phases {
+			if backup.Status.Phase == phase {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// isBackupCompleted waits until the named Backup has completed.
+func isBackupCompleted(namespace, backupName string) wait.ConditionFunc {
+	return backupPhase(namespace, backupName, velero.BackupPhaseCompleted)
+}
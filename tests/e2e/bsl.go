@@ -0,0 +1,201 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// BSLConfig describes one BackupStorageLocation to install alongside a
+// Velero CR, independent of provider. "aws" against an S3-compatible
+// endpoint such as MinIO or Ceph is expressed by setting S3Url (and usually
+// S3ForcePathStyle), rather than a separate provider value.
+type BSLConfig struct {
+	Name             string
+	Provider         string
+	CredentialSecret string
+	CredentialKey    string
+	Bucket           string
+	Prefix           string
+	Region           string
+	S3Url            string
+	S3ForcePathStyle bool
+	Config           map[string]string
+	Default          bool
+}
+
+// WithBSLConfigs sets the BackupStorageLocations to install with the
+// Velero CR, replacing the legacy single-AWS-BSL default.
+func (v *veleroCustomResource) WithBSLConfigs(bsls ...BSLConfig) *veleroCustomResource {
+	v.BSLConfigs = bsls
+	return v
+}
+
+// defaultPluginForProvider returns the DefaultVeleroPlugin matching the BSL
+// provider. AWS-compatible endpoints (MinIO, Ceph) still use the AWS plugin.
+func defaultPluginForProvider(provider string) oadpv1alpha1.DefaultPlugin {
+	switch provider {
+	case "gcp":
+		return oadpv1alpha1.DefaultPluginGCP
+	case "azure":
+		return oadpv1alpha1.DefaultPluginMicrosoftAzure
+	default:
+		return oadpv1alpha1.DefaultPluginAWS
+	}
+}
+
+// toBackupStorageLocationSpec turns a BSLConfig into the velero
+// BackupStorageLocationSpec the operator expects on the Velero CR.
+func (b BSLConfig) toBackupStorageLocationSpec() velero.BackupStorageLocationSpec {
+	config := map[string]string{}
+	for k, v := range b.Config {
+		config[k] = v
+	}
+	if b.Region != "" {
+		config["region"] = b.Region
+	}
+	if b.S3Url != "" {
+		config["s3Url"] = b.S3Url
+	}
+	if b.S3ForcePathStyle {
+		config["s3ForcePathStyle"] = "true"
+	}
+
+	spec := velero.BackupStorageLocationSpec{
+		Provider: b.Provider,
+		Config:   config,
+		Default:  b.Default,
+		StorageType: velero.StorageType{
+			ObjectStorage: &velero.ObjectStorageLocation{
+				Bucket: b.Bucket,
+				Prefix: b.Prefix,
+			},
+		},
+	}
+
+	if b.CredentialSecret != "" {
+		credentialKey := b.CredentialKey
+		if credentialKey == "" {
+			credentialKey = "cloud"
+		}
+		spec.Credential = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: b.CredentialSecret},
+			Key:                  credentialKey,
+		}
+	}
+
+	return spec
+}
+
+// pluginsForBSLConfigs returns the de-duplicated set of DefaultVeleroPlugins
+// needed to support every configured BSL, always including OpenShift.
+func pluginsForBSLConfigs(bsls []BSLConfig) []oadpv1alpha1.DefaultPlugin {
+	plugins := []oadpv1alpha1.DefaultPlugin{oadpv1alpha1.DefaultPluginOpenShift}
+	seen := map[oadpv1alpha1.DefaultPlugin]bool{oadpv1alpha1.DefaultPluginOpenShift: true}
+	for _, bsl := range bsls {
+		plugin := defaultPluginForProvider(bsl.Provider)
+		if !seen[plugin] {
+			seen[plugin] = true
+			plugins = append(plugins, plugin)
+		}
+	}
+	return plugins
+}
+
+// buildBSLSecret creates the credentials Secret backing a BSLConfig,
+// reading either a shared-credentials-file ([profile]\nkey = value ini
+// sections) or a flat "config" style key=value file, matching how upstream
+// e2e now parses AWS credentials in either format.
+func buildBSLSecret(namespace, secretName, credentialsFile string) (*corev1.Secret, error) {
+	data, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+	if isSharedCredentialsFile(data) {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+			Data:       map[string][]byte{"cloud": data},
+		}, nil
+	}
+
+	configData, err := parseKeyValueCredentials(data)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+		StringData: configData,
+	}, nil
+}
+
+// isSharedCredentialsFile reports whether the credentials file is in the
+// AWS shared-credentials-file ini format, i.e. it contains a "[profile]"
+// section header.
+func isSharedCredentialsFile(data []byte) bool {
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			return true
+		}
+	}
+	return false
+}
+
+// parseKeyValueCredentials parses a flat "key=value" per line credentials
+// file into a map, e.g. "aws_access_key_id=...".
+func parseKeyValueCredentials(data []byte) (map[string]string, error) {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("malformed credentials line: %q", line)
+		}
+		values[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return values, nil
+}
+
+// findBSLName looks up the Kubernetes name the operator assigned to the
+// BackupStorageLocation it created for a given BSLConfig, matching on
+// bucket+prefix. BSLConfig.Name is only a label for the test's own
+// bookkeeping; the operator derives the live object's name itself (e.g.
+// from the Velero CR name and BSL index), so callers that need to target a
+// specific BSL (via Backup.Spec.StorageLocation) must look it up rather
+// than assume BSLConfig.Name matches.
+func findBSLName(namespace string, bsl BSLConfig) (string, error) {
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		return "", err
+	}
+	velero.AddToScheme(c.Scheme())
+
+	list := velero.BackupStorageLocationList{}
+	if err := c.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+	for _, candidate := range list.Items {
+		if candidate.Spec.StorageType.ObjectStorage == nil {
+			continue
+		}
+		if candidate.Spec.StorageType.ObjectStorage.Bucket == bsl.Bucket &&
+			candidate.Spec.StorageType.ObjectStorage.Prefix == bsl.Prefix {
+			return candidate.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no BackupStorageLocation found in namespace %q for bucket %q prefix %q", namespace, bsl.Bucket, bsl.Prefix)
+}
@@ -0,0 +1,79 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// TestMultiBSLBackupsBothComplete installs a single Velero instance with
+// two simultaneous BackupStorageLocations (AWS and a MinIO endpoint
+// speaking the S3 API), then runs a backup against each by name and
+// asserts both complete.
+func TestMultiBSLBackupsBothComplete(t *testing.T) {
+	if testing.Short() {
+		t.Skip("requires a live cluster with Velero/OADP installed")
+	}
+
+	awsBSL := BSLConfig{
+		Name:             "aws",
+		Provider:         "aws",
+		CredentialSecret: "cloud-credentials",
+		Bucket:           e2eBucket,
+		Prefix:           veleroPrefix + "-aws",
+		Region:           "us-east-1",
+		Default:          true,
+	}
+	minioBSL := BSLConfig{
+		Name:             "minio",
+		Provider:         "aws",
+		CredentialSecret: "cloud-credentials-minio",
+		Bucket:           e2eBucket,
+		Prefix:           veleroPrefix + "-minio",
+		S3Url:            "http://minio.e2e.svc:9000",
+		S3ForcePathStyle: true,
+	}
+
+	vcr := &veleroCustomResource{
+		Name:      "velero-multi-bsl",
+		Namespace: e2eNamespace,
+	}
+	vcr.WithBSLConfigs(awsBSL, minioBSL)
+	if err := vcr.Build(); err != nil {
+		t.Fatalf("Build() failed: %v", err)
+	}
+	if err := vcr.Create(); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := vcr.Delete(); err != nil {
+			t.Logf("cleanup: failed to delete Velero CR %s: %v", vcr.Name, err)
+		}
+	})
+
+	if err := wait.PollImmediate(5*time.Second, 5*time.Minute, isVeleroPodRunning(e2eNamespace)); err != nil {
+		t.Fatalf("velero pod never became ready: %v", err)
+	}
+
+	for _, bsl := range []BSLConfig{awsBSL, minioBSL} {
+		bsl := bsl
+		t.Run(bsl.Name, func(t *testing.T) {
+			// BSLConfig.Name is only this test's own label; the operator
+			// assigns the live BackupStorageLocation its own Kubernetes
+			// name, so look that up by bucket+prefix before targeting it.
+			liveName, err := findBSLName(e2eNamespace, bsl)
+			if err != nil {
+				t.Fatalf("failed to find live BackupStorageLocation for %s: %v", bsl.Name, err)
+			}
+
+			backupName := "backup-" + bsl.Name
+			if err := createBackupForBSL(e2eNamespace, backupName, liveName, []string{workloadNamespace}); err != nil {
+				t.Fatalf("failed to create backup against BSL %s: %v", bsl.Name, err)
+			}
+			if err := wait.PollImmediate(10*time.Second, 10*time.Minute, isBackupCompleted(e2eNamespace, backupName)); err != nil {
+				t.Fatalf("backup against BSL %s did not complete: %v", bsl.Name, err)
+			}
+		})
+	}
+}
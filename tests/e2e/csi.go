@@ -0,0 +1,165 @@
+package e2e
+
+import (
+	"context"
+
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// WithCSI enables the CSI plugin and EnableCSI on the Velero CR.
+func (v *veleroCustomResource) WithCSI() *veleroCustomResource {
+	v.EnableCSI = true
+	return v
+}
+
+func csiClient() (client.Client, error) {
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	snapshotv1.AddToScheme(c.Scheme())
+	velero.AddToScheme(c.Scheme())
+	velerov2alpha1.AddToScheme(c.Scheme())
+	return c, nil
+}
+
+// getVolumeSnapshots returns the VolumeSnapshots in the given namespace.
+func getVolumeSnapshots(namespace string) (*snapshotv1.VolumeSnapshotList, error) {
+	c, err := csiClient()
+	if err != nil {
+		return nil, err
+	}
+	list := snapshotv1.VolumeSnapshotList{}
+	if err := c.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// getVolumeSnapshotContents returns the cluster-scoped VolumeSnapshotContents.
+func getVolumeSnapshotContents() (*snapshotv1.VolumeSnapshotContentList, error) {
+	c, err := csiClient()
+	if err != nil {
+		return nil, err
+	}
+	list := snapshotv1.VolumeSnapshotContentList{}
+	if err := c.List(context.Background(), &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// getDataUploads returns the Velero DataUploads in the given namespace,
+// which exist only when snapshotMoveData is enabled on the backup.
+func getDataUploads(namespace string) (*velerov2alpha1.DataUploadList, error) {
+	c, err := csiClient()
+	if err != nil {
+		return nil, err
+	}
+	list := velerov2alpha1.DataUploadList{}
+	if err := c.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// getDataDownloads returns the Velero DataDownloads in the given namespace,
+// created during a data-mover restore.
+func getDataDownloads(namespace string) (*velerov2alpha1.DataDownloadList, error) {
+	c, err := csiClient()
+	if err != nil {
+		return nil, err
+	}
+	list := velerov2alpha1.DataDownloadList{}
+	if err := c.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// allVolumeSnapshotsReady waits until every VolumeSnapshot in the namespace
+// reports ReadyToUse.
+func allVolumeSnapshotsReady(namespace string) wait.ConditionFunc {
+	return func() (bool, error) {
+		list, err := getVolumeSnapshots(namespace)
+		if err != nil {
+			return false, err
+		}
+		if len(list.Items) == 0 {
+			return false, nil
+		}
+		for _, vs := range list.Items {
+			if vs.Status == nil || vs.Status.ReadyToUse == nil || !*vs.Status.ReadyToUse {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// dataUploadsCompleted waits until every DataUpload in the namespace has
+// completed, for data-mover backups.
+func dataUploadsCompleted(namespace string) wait.ConditionFunc {
+	return func() (bool, error) {
+		list, err := getDataUploads(namespace)
+		if err != nil {
+			return false, err
+		}
+		if len(list.Items) == 0 {
+			return false, nil
+		}
+		for _, du := range list.Items {
+			if du.Status.Phase != velerov2alpha1.DataUploadPhaseCompleted {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// dataDownloadsCompleted waits until every DataDownload in the namespace
+// has completed, for data-mover restores.
+func dataDownloadsCompleted(namespace string) wait.ConditionFunc {
+	return func() (bool, error) {
+		list, err := getDataDownloads(namespace)
+		if err != nil {
+			return false, err
+		}
+		if len(list.Items) == 0 {
+			return false, nil
+		}
+		for _, dd := range list.Items {
+			if dd.Status.Phase != velerov2alpha1.DataDownloadPhaseCompleted {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// createCSIBackup creates a Backup with snapshotMoveData set, so snapshot
+// data is moved into object storage instead of staying in the storage
+// system.
+func createCSIBackup(namespace, backupName string, includedNamespaces []string, snapshotMoveData bool) error {
+	c, err := csiClient()
+	if err != nil {
+		return err
+	}
+	backup := &velero.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName,
+			Namespace: namespace,
+		},
+		Spec: velero.BackupSpec{
+			IncludedNamespaces: includedNamespaces,
+			SnapshotMoveData:   &snapshotMoveData,
+		},
+	}
+	return c.Create(context.Background(), backup)
+}
@@ -0,0 +1,120 @@
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/oadp-operator/tests/e2e/util/k8s"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// TestCSIBackupRestore installs a Velero instance with the CSI plugin
+// enabled, backs up a CSI-backed PVC both with and without
+// snapshotMoveData, and asserts the resulting data location: snapshot data
+// stays in the storage system (no DataUploads) unless data movement is
+// enabled, in which case it must be moved into the object storage bucket
+// (DataUploads complete). The data movement case additionally restores the
+// backup into a new namespace and confirms the restored file's contents
+// match what was written before the backup.
+func TestCSIBackupRestore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("requires a live cluster with Velero/OADP and a CSI-backed StorageClass installed")
+	}
+
+	vcr := &veleroCustomResource{
+		Name:      "velero-csi",
+		Namespace: e2eNamespace,
+		Provider:  "aws",
+		Region:    "us-east-1",
+		Bucket:    e2eBucket,
+	}
+	vcr.WithCSI()
+	if err := vcr.Build(); err != nil {
+		t.Fatalf("Build() failed: %v", err)
+	}
+	if err := vcr.Create(); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := vcr.Delete(); err != nil {
+			t.Logf("cleanup: failed to delete Velero CR %s: %v", vcr.Name, err)
+		}
+	})
+
+	if err := wait.PollImmediate(5*time.Second, 5*time.Minute, isVeleroPodRunning(e2eNamespace)); err != nil {
+		t.Fatalf("velero pod never became ready: %v", err)
+	}
+
+	t.Run("snapshot stays in storage system without data movement", func(t *testing.T) {
+		backupName := "csi-backup-no-datamover"
+		if err := createCSIBackup(e2eNamespace, backupName, []string{workloadNamespace}, false); err != nil {
+			t.Fatalf("failed to create CSI backup: %v", err)
+		}
+		if err := wait.PollImmediate(10*time.Second, 10*time.Minute, allVolumeSnapshotsReady(workloadNamespace)); err != nil {
+			t.Fatalf("VolumeSnapshots never became ready: %v", err)
+		}
+
+		dataUploads, err := getDataUploads(e2eNamespace)
+		if err != nil {
+			t.Fatalf("failed to list DataUploads: %v", err)
+		}
+		if len(dataUploads.Items) != 0 {
+			t.Fatalf("expected no DataUploads when snapshotMoveData is false, found %d", len(dataUploads.Items))
+		}
+	})
+
+	t.Run("snapshot data is moved to object storage with data movement enabled and restores intact", func(t *testing.T) {
+		c, err := client.New(config.GetConfigOrDie(), client.Options{})
+		if err != nil {
+			t.Fatalf("failed to build client: %v", err)
+		}
+
+		podName := "csi-datamover-pod"
+		pvcName := podName + "-data"
+		if _, err := k8s.CreatePVC(c, workloadNamespace, pvcName, "csi-storage-class", "1Gi"); err != nil {
+			t.Fatalf("failed to create PVC %s: %v", pvcName, err)
+		}
+		if _, err := k8s.CreatePodWithVolumeAnnotation(c, workloadNamespace, podName, map[string]string{"data": pvcName}, nil, false); err != nil {
+			t.Fatalf("failed to create pod %s: %v", podName, err)
+		}
+		if err := wait.PollImmediate(5*time.Second, 2*time.Minute, k8s.IsPodRunning(c, workloadNamespace, podName)); err != nil {
+			t.Fatalf("pod %s never became ready: %v", podName, err)
+		}
+		restConfig := config.GetConfigOrDie()
+		if _, err := k8s.ExecInPod(restConfig, workloadNamespace, podName, []string{"sh", "-c", fmt.Sprintf("echo -n %s > /data/data/file.txt", fileContent)}); err != nil {
+			t.Fatalf("failed to write data into volume: %v", err)
+		}
+
+		backupName := "csi-backup-datamover"
+		if err := createCSIBackup(e2eNamespace, backupName, []string{workloadNamespace}, true); err != nil {
+			t.Fatalf("failed to create CSI backup: %v", err)
+		}
+		if err := wait.PollImmediate(10*time.Second, 15*time.Minute, dataUploadsCompleted(e2eNamespace)); err != nil {
+			t.Fatalf("DataUploads did not complete for snapshotMoveData backup: %v", err)
+		}
+
+		restoreNamespace := workloadNamespace + "-csi-restore"
+		restoreName := "restore-" + backupName
+		if err := createRestore(e2eNamespace, restoreName, backupName, map[string]string{workloadNamespace: restoreNamespace}); err != nil {
+			t.Fatalf("failed to create restore: %v", err)
+		}
+		if err := wait.PollImmediate(10*time.Second, 15*time.Minute, isRestoreCompleted(e2eNamespace, restoreName)); err != nil {
+			t.Fatalf("restore did not complete: %v", err)
+		}
+		if err := wait.PollImmediate(10*time.Second, 15*time.Minute, dataDownloadsCompleted(restoreNamespace)); err != nil {
+			t.Fatalf("DataDownloads did not complete for restore: %v", err)
+		}
+		if err := wait.PollImmediate(5*time.Second, 2*time.Minute, k8s.IsPodRunning(c, restoreNamespace, podName)); err != nil {
+			t.Fatalf("restored pod %s never became ready: %v", podName, err)
+		}
+
+		out, err := k8s.ExecInPod(restConfig, restoreNamespace, podName, []string{"cat", "/data/data/file.txt"})
+		if err != nil || strings.TrimSpace(out) != fileContent {
+			t.Fatalf("restored file contents = %q, err = %v; want %q", out, err, fileContent)
+		}
+	})
+}
@@ -0,0 +1,273 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// logSeverity is an ordered Velero log level, used to compare against a
+// configured threshold.
+type logSeverity int
+
+const (
+	logSeverityDebug logSeverity = iota
+	logSeverityInfo
+	logSeverityWarn
+	logSeverityError
+	logSeverityFatal
+)
+
+func parseLogSeverity(level string) logSeverity {
+	switch strings.ToLower(level) {
+	case "warn", "warning":
+		return logSeverityWarn
+	case "error":
+		return logSeverityError
+	case "fatal", "panic":
+		return logSeverityFatal
+	case "debug":
+		return logSeverityDebug
+	default:
+		return logSeverityInfo
+	}
+}
+
+// VeleroLogEntry is a single parsed Velero log line, in either logfmt or
+// JSON format.
+type VeleroLogEntry struct {
+	Level  string
+	Time   string
+	Msg    string
+	Fields map[string]string
+}
+
+// logger returns the "logger" field if present, e.g. "backup", "restore",
+// or "repository", identifying which component emitted the entry.
+func (e VeleroLogEntry) logger() string {
+	return e.Fields["logger"]
+}
+
+// parseVeleroLogLine parses a single Velero log line as JSON first, falling
+// back to logfmt, since the velero container can be configured to emit
+// either.
+func parseVeleroLogLine(line string) (VeleroLogEntry, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return VeleroLogEntry{}, false
+	}
+	if strings.HasPrefix(line, "{") {
+		return parseJSONLogLine(line)
+	}
+	return parseLogfmtLine(line)
+}
+
+func parseJSONLogLine(line string) (VeleroLogEntry, bool) {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(line), &raw); err != nil {
+		return VeleroLogEntry{}, false
+	}
+	fields := make(map[string]string, len(raw))
+	for k, v := range raw {
+		fields[k] = stringifyLogValue(v)
+	}
+	entry := VeleroLogEntry{
+		Level:  fields["level"],
+		Time:   fields["time"],
+		Msg:    fields["msg"],
+		Fields: fields,
+	}
+	return entry, true
+}
+
+// stringifyLogValue renders a decoded JSON log field (string, number, bool,
+// or nested object/array such as a stacktrace) as a string, since
+// VeleroLogEntry.Fields is keyed by string for easy filtering.
+func stringifyLogValue(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case nil:
+		return ""
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		return string(b)
+	}
+}
+
+// parseLogfmtLine tokenizes a logfmt line into key=value fields, treating
+// double-quoted values as a single token even when they contain spaces
+// (e.g. msg="backup failed: timeout exceeded").
+func parseLogfmtLine(line string) (VeleroLogEntry, bool) {
+	fields := map[string]string{}
+	i, n := 0, len(line)
+	for i < n {
+		for i < n && line[i] == ' ' {
+			i++
+		}
+		if i >= n {
+			break
+		}
+		keyStart := i
+		for i < n && line[i] != '=' && line[i] != ' ' {
+			i++
+		}
+		if i >= n || line[i] != '=' {
+			// No '=' for this token; skip it and move on.
+			for i < n && line[i] != ' ' {
+				i++
+			}
+			continue
+		}
+		key := line[keyStart:i]
+		i++ // consume '='
+
+		var value string
+		if i < n && line[i] == '"' {
+			i++
+			valStart := i
+			for i < n && line[i] != '"' {
+				if line[i] == '\\' && i+1 < n {
+					i += 2
+					continue
+				}
+				i++
+			}
+			value = strings.ReplaceAll(line[valStart:i], `\"`, `"`)
+			if i < n {
+				i++ // consume closing quote
+			}
+		} else {
+			valStart := i
+			for i < n && line[i] != ' ' {
+				i++
+			}
+			value = line[valStart:i]
+		}
+		fields[key] = value
+	}
+	if len(fields) == 0 {
+		return VeleroLogEntry{}, false
+	}
+	entry := VeleroLogEntry{
+		Level:  fields["level"],
+		Time:   fields["time"],
+		Msg:    fields["msg"],
+		Fields: fields,
+	}
+	return entry, true
+}
+
+// getVeleroContainerLogEntries parses the velero container logs into typed
+// VeleroLogEntry values, replacing the ad-hoc strings.Contains scan.
+func getVeleroContainerLogEntries(namespace string) ([]VeleroLogEntry, error) {
+	containerLogs, err := getVeleroContainerLogs(namespace)
+	if err != nil {
+		return nil, err
+	}
+	var entries []VeleroLogEntry
+	for _, line := range strings.Split(containerLogs, "\n") {
+		entry, ok := parseVeleroLogLine(line)
+		if !ok {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// getVeleroContainerEntriesAtOrAbove returns log entries at or above the
+// given severity threshold, optionally restricted to a logger/component
+// (e.g. "backup", "restore", "repository"). An empty logger matches all.
+func getVeleroContainerEntriesAtOrAbove(namespace, minSeverity, logger string) ([]VeleroLogEntry, error) {
+	entries, err := getVeleroContainerLogEntries(namespace)
+	if err != nil {
+		return nil, err
+	}
+	threshold := parseLogSeverity(minSeverity)
+	var filtered []VeleroLogEntry
+	for _, entry := range entries {
+		if parseLogSeverity(entry.Level) < threshold {
+			continue
+		}
+		if logger != "" && entry.logger() != logger {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered, nil
+}
+
+// getVeleroContainerFailureLogs preserves the previous string-based summary
+// used by existing callers, now backed by the structured log parser.
+func getVeleroContainerFailureLogs(namespace string) []string {
+	entries, err := getVeleroContainerEntriesAtOrAbove(namespace, "error", "")
+	if err != nil {
+		return nil
+	}
+	var failureArr = []string{}
+	for i, entry := range entries {
+		failureArr = append(failureArr, fmt.Sprintf("velero container error line#%d: level=%s msg=%s\n", i, entry.Level, entry.Msg))
+	}
+	return failureArr
+}
+
+// getClusterEvents captures the Kubernetes Events recorded for the given
+// namespace during the current test window.
+func getClusterEvents(namespace string) (*corev1.EventList, error) {
+	clientset, err := setUpClient()
+	if err != nil {
+		return nil, err
+	}
+	events, err := clientset.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// DumpDiagnostics writes the velero container logs and the namespace's
+// Kubernetes Events to per-test artifact files under ARTIFACT_DIR (or the
+// current directory if unset), so e2e failures are debuggable after the
+// fact.
+func DumpDiagnostics(namespace, testName string) error {
+	artifactDir := os.Getenv("ARTIFACT_DIR")
+	if artifactDir == "" {
+		artifactDir = "."
+	}
+	if err := os.MkdirAll(artifactDir, 0o755); err != nil {
+		return err
+	}
+	sanitized := strings.NewReplacer(" ", "_", "/", "_").Replace(testName)
+	timestamp := time.Now().Format("20060102-150405")
+
+	logs, err := getVeleroContainerLogs(namespace)
+	if err != nil {
+		return err
+	}
+	logPath := filepath.Join(artifactDir, fmt.Sprintf("%s-%s-velero.log", sanitized, timestamp))
+	if err := os.WriteFile(logPath, []byte(logs), 0o644); err != nil {
+		return err
+	}
+
+	events, err := getClusterEvents(namespace)
+	if err != nil {
+		return err
+	}
+	eventsJSON, err := json.MarshalIndent(events.Items, "", "  ")
+	if err != nil {
+		return err
+	}
+	eventsPath := filepath.Join(artifactDir, fmt.Sprintf("%s-%s-events.json", sanitized, timestamp))
+	return os.WriteFile(eventsPath, eventsJSON, 0o644)
+}
@@ -0,0 +1,13 @@
+package e2e
+
+// Shared fixtures for the e2e test suite. testing.Short() gates every test
+// here since they all require a live cluster with OADP/Velero installed.
+const (
+	// e2eNamespace is the namespace Velero/OADP is installed into.
+	e2eNamespace = "openshift-adp"
+	// workloadNamespace hosts the PVC-backed pods used by pod-volume
+	// backup/restore tests.
+	workloadNamespace = "e2e-pod-volume"
+	// e2eBucket is the object storage bucket backing every BSL in this suite.
+	e2eBucket = "oadp-e2e-qe"
+)
@@ -0,0 +1,70 @@
+package e2e
+
+import (
+	"context"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// maintenanceJobLabelSelector matches the jobs the operator creates to prune
+// and check BackupRepositories on a schedule.
+const maintenanceJobLabelSelector = "velero.io/repository-maintenance-job=true"
+
+// getMaintenanceJobs returns the repository maintenance Jobs in the given
+// namespace, analogous to getVeleroPods.
+func getMaintenanceJobs(namespace string) (*batchv1.JobList, error) {
+	clientset, err := setUpClient()
+	if err != nil {
+		return nil, err
+	}
+	maintenanceOptions := metav1.ListOptions{
+		LabelSelector: maintenanceJobLabelSelector,
+	}
+	jobList, err := clientset.BatchV1().Jobs(namespace).List(context.TODO(), maintenanceOptions)
+	if err != nil {
+		return nil, err
+	}
+	return jobList, nil
+}
+
+// areMaintenanceJobsCreated waits until at least one repository maintenance
+// Job has been created in the namespace.
+func areMaintenanceJobsCreated(namespace string) wait.ConditionFunc {
+	return func() (bool, error) {
+		jobList, err := getMaintenanceJobs(namespace)
+		if err != nil {
+			return false, err
+		}
+		return len(jobList.Items) > 0, nil
+	}
+}
+
+// maintenanceJobsSucceeded waits until every repository maintenance Job in
+// the namespace has completed successfully.
+func maintenanceJobsSucceeded(namespace string) wait.ConditionFunc {
+	return func() (bool, error) {
+		jobList, err := getMaintenanceJobs(namespace)
+		if err != nil {
+			return false, err
+		}
+		if len(jobList.Items) == 0 {
+			return false, nil
+		}
+		for _, job := range jobList.Items {
+			if job.Status.Succeeded < 1 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// WithMaintenanceConfig sets the repository maintenance job configuration
+// on the Velero CR before it is built.
+func (v *veleroCustomResource) WithMaintenanceConfig(cfg oadpv1alpha1.MaintenanceConfig) *veleroCustomResource {
+	v.MaintenanceConfig = &cfg
+	return v
+}
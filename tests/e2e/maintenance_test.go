@@ -0,0 +1,65 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/pointer"
+)
+
+// TestMaintenanceJobsRunOnSchedule installs a Velero instance with a short
+// maintenance frequency and asserts that repository maintenance Jobs get
+// created on schedule and complete successfully.
+func TestMaintenanceJobsRunOnSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("requires a live cluster with Velero/OADP installed")
+	}
+
+	vcr := &veleroCustomResource{
+		Name:      "velero-maintenance",
+		Namespace: e2eNamespace,
+		Provider:  "aws",
+		Region:    "us-east-1",
+		Bucket:    e2eBucket,
+	}
+	vcr.WithMaintenanceConfig(oadpv1alpha1.MaintenanceConfig{
+		KeepLatestMaintenanceJobs: 3,
+		PodResources: oadpv1alpha1.PodResourceRequirements{
+			CPURequest:    "100m",
+			MemoryRequest: "128Mi",
+			CPULimit:      "200m",
+			MemoryLimit:   "256Mi",
+		},
+		FrequencyMinutes: pointer.Int64(1),
+	})
+	if err := vcr.Build(); err != nil {
+		t.Fatalf("Build() failed: %v", err)
+	}
+	if err := vcr.Create(); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			if err := DumpDiagnostics(e2eNamespace, t.Name()); err != nil {
+				t.Logf("cleanup: failed to dump diagnostics: %v", err)
+			}
+		}
+		if err := vcr.Delete(); err != nil {
+			t.Logf("cleanup: failed to delete Velero CR %s: %v", vcr.Name, err)
+		}
+	})
+
+	if err := wait.PollImmediate(5*time.Second, 5*time.Minute, isVeleroPodRunning(e2eNamespace)); err != nil {
+		t.Fatalf("velero pod never became ready: %v", err)
+	}
+
+	if err := wait.PollImmediate(10*time.Second, 5*time.Minute, areMaintenanceJobsCreated(e2eNamespace)); err != nil {
+		t.Fatalf("no repository maintenance Jobs were created: %v", err)
+	}
+
+	if err := wait.PollImmediate(10*time.Second, 10*time.Minute, maintenanceJobsSucceeded(e2eNamespace)); err != nil {
+		t.Fatalf("repository maintenance Jobs did not all succeed: %v", err)
+	}
+}
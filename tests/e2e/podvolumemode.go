@@ -0,0 +1,41 @@
+package e2e
+
+import (
+	"context"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// WithPodVolumeMode sets the pod-volume backup mode (opt-in or opt-out) on
+// the Velero CR before it is built.
+func (v *veleroCustomResource) WithPodVolumeMode(mode oadpv1alpha1.PodVolumeBackupMode) *veleroCustomResource {
+	v.PodVolumeBackupMode = mode
+	return v
+}
+
+// getPodVolumeBackupsForPod returns the PodVolumeBackups Velero created for
+// the given pod, so tests can assert which volumes actually got backed up
+// under opt-in vs opt-out mode.
+func getPodVolumeBackupsForPod(veleroNamespace, podNamespace, podName string) ([]velero.PodVolumeBackup, error) {
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	velero.AddToScheme(c.Scheme())
+
+	list := velero.PodVolumeBackupList{}
+	if err := c.List(context.Background(), &list, client.InNamespace(veleroNamespace)); err != nil {
+		return nil, err
+	}
+
+	var forPod []velero.PodVolumeBackup
+	for _, pvb := range list.Items {
+		if pvb.Spec.Pod.Namespace == podNamespace && pvb.Spec.Pod.Name == podName {
+			forPod = append(forPod, pvb)
+		}
+	}
+	return forPod, nil
+}
@@ -0,0 +1,167 @@
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	"github.com/openshift/oadp-operator/tests/e2e/util/k8s"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// fileContent is written to every backed-up volume before the backup runs,
+// so a restore can be checked for the actual bytes coming back rather than
+// just the PodVolumeBackup/Restore objects reaching Completed.
+const fileContent = "pod-volume-backup-mode-data-integrity-check"
+
+// backedUpVolumeNames returns the set of volume names Velero actually
+// created PodVolumeBackups for.
+func backedUpVolumeNames(pvbs []velero.PodVolumeBackup) map[string]bool {
+	names := map[string]bool{}
+	for _, pvb := range pvbs {
+		names[pvb.Spec.Volume] = true
+	}
+	return names
+}
+
+// TestPodVolumeBackupModeOptInOptOut installs paired Velero instances in
+// opt-in and opt-out pod-volume backup mode and confirms each only backs up
+// the volumes it's supposed to: opt-in backs up only annotated volumes,
+// opt-out backs up everything except excluded volumes.
+func TestPodVolumeBackupModeOptInOptOut(t *testing.T) {
+	if testing.Short() {
+		t.Skip("requires a live cluster with Velero/OADP installed")
+	}
+
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	t.Run("opt-in backs up only annotated volumes", func(t *testing.T) {
+		vcr := &veleroCustomResource{
+			Name:      "velero-pvb-opt-in",
+			Namespace: e2eNamespace,
+			Provider:  "aws",
+			Region:    "us-east-1",
+			Bucket:    e2eBucket,
+		}
+		vcr.WithPodVolumeMode(oadpv1alpha1.PodVolumeBackupModeOptIn)
+		runPodVolumeBackupModeCase(t, c, vcr, "pod-opt-in", []string{"data-included"}, false, map[string]bool{
+			"data-included": true,
+			"data-excluded": false,
+		})
+	})
+
+	t.Run("opt-out backs up everything except excluded volumes", func(t *testing.T) {
+		vcr := &veleroCustomResource{
+			Name:      "velero-pvb-opt-out",
+			Namespace: e2eNamespace,
+			Provider:  "aws",
+			Region:    "us-east-1",
+			Bucket:    e2eBucket,
+		}
+		vcr.WithPodVolumeMode(oadpv1alpha1.PodVolumeBackupModeOptOut)
+		runPodVolumeBackupModeCase(t, c, vcr, "pod-opt-out", []string{"data-excluded"}, true, map[string]bool{
+			"data-included": true,
+			"data-excluded": false,
+		})
+	})
+}
+
+// runPodVolumeBackupModeCase installs the given Velero CR, creates a pod
+// with two PVC-backed volumes ("data-included" and "data-excluded")
+// annotated per annotateExclude, backs up its namespace, and asserts the
+// resulting PodVolumeBackups match wantBackedUp.
+func runPodVolumeBackupModeCase(t *testing.T, c client.Client, vcr *veleroCustomResource, podName string, annotateVolumes []string, annotateExclude bool, wantBackedUp map[string]bool) {
+	t.Helper()
+
+	if err := vcr.Build(); err != nil {
+		t.Fatalf("Build() failed: %v", err)
+	}
+	if err := vcr.Create(); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := vcr.Delete(); err != nil {
+			t.Logf("cleanup: failed to delete Velero CR %s: %v", vcr.Name, err)
+		}
+	})
+
+	if err := wait.PollImmediate(5*time.Second, 5*time.Minute, isVeleroPodRunning(e2eNamespace)); err != nil {
+		t.Fatalf("velero pod never became ready: %v", err)
+	}
+
+	for _, volumeName := range []string{"data-included", "data-excluded"} {
+		pvcName := fmt.Sprintf("%s-%s", podName, volumeName)
+		if _, err := k8s.CreatePVC(c, workloadNamespace, pvcName, "csi-storage-class", "1Gi"); err != nil {
+			t.Fatalf("failed to create PVC %s: %v", pvcName, err)
+		}
+	}
+
+	volumeToPVC := map[string]string{
+		"data-included": fmt.Sprintf("%s-data-included", podName),
+		"data-excluded": fmt.Sprintf("%s-data-excluded", podName),
+	}
+	if _, err := k8s.CreatePodWithVolumeAnnotation(c, workloadNamespace, podName, volumeToPVC, annotateVolumes, annotateExclude); err != nil {
+		t.Fatalf("failed to create pod %s: %v", podName, err)
+	}
+	if err := wait.PollImmediate(5*time.Second, 2*time.Minute, k8s.IsPodRunning(c, workloadNamespace, podName)); err != nil {
+		t.Fatalf("pod %s never became ready: %v", podName, err)
+	}
+	restConfig := config.GetConfigOrDie()
+	for volumeName := range volumeToPVC {
+		if _, err := k8s.ExecInPod(restConfig, workloadNamespace, podName, []string{"sh", "-c", fmt.Sprintf("echo -n %s > /data/%s/file.txt", fileContent, volumeName)}); err != nil {
+			t.Fatalf("failed to write data into volume %s: %v", volumeName, err)
+		}
+	}
+
+	backupName := "pvb-" + podName
+	if err := createBackupForBSL(e2eNamespace, backupName, "default", []string{workloadNamespace}); err != nil {
+		t.Fatalf("failed to create backup: %v", err)
+	}
+	if err := wait.PollImmediate(10*time.Second, 10*time.Minute, isBackupCompleted(e2eNamespace, backupName)); err != nil {
+		t.Fatalf("backup did not complete: %v", err)
+	}
+
+	pvbs, err := getPodVolumeBackupsForPod(e2eNamespace, workloadNamespace, podName)
+	if err != nil {
+		t.Fatalf("failed to list PodVolumeBackups: %v", err)
+	}
+	got := backedUpVolumeNames(pvbs)
+	for volumeName, want := range wantBackedUp {
+		if got[volumeName] != want {
+			t.Errorf("volume %s: got backed-up=%v, want %v", volumeName, got[volumeName], want)
+		}
+	}
+
+	restoreNamespace := workloadNamespace + "-restore-" + podName
+	restoreName := "restore-" + podName
+	if err := createRestore(e2eNamespace, restoreName, backupName, map[string]string{workloadNamespace: restoreNamespace}); err != nil {
+		t.Fatalf("failed to create restore: %v", err)
+	}
+	if err := wait.PollImmediate(10*time.Second, 10*time.Minute, isRestoreCompleted(e2eNamespace, restoreName)); err != nil {
+		t.Fatalf("restore did not complete: %v", err)
+	}
+	if err := wait.PollImmediate(5*time.Second, 2*time.Minute, k8s.IsPodRunning(c, restoreNamespace, podName)); err != nil {
+		t.Fatalf("restored pod %s never became ready: %v", podName, err)
+	}
+
+	for volumeName, wasBackedUp := range wantBackedUp {
+		out, err := k8s.ExecInPod(restConfig, restoreNamespace, podName, []string{"cat", fmt.Sprintf("/data/%s/file.txt", volumeName)})
+		if wasBackedUp {
+			if err != nil || strings.TrimSpace(out) != fileContent {
+				t.Errorf("volume %s: restored file contents = %q, err = %v; want %q", volumeName, out, err, fileContent)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("volume %s: expected no restored data (not backed up), but found file contents %q", volumeName, out)
+		}
+	}
+}
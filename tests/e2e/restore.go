@@ -0,0 +1,62 @@
+package e2e
+
+import (
+	"context"
+
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// createRestore creates a Restore of the named Backup into restoreIntoNamespaceMapping,
+// so a workload backed up in one namespace can be verified by restoring it
+// into another and comparing contents.
+func createRestore(namespace, restoreName, backupName string, namespaceMapping map[string]string) error {
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		return err
+	}
+	velero.AddToScheme(c.Scheme())
+
+	restore := &velero.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      restoreName,
+			Namespace: namespace,
+		},
+		Spec: velero.RestoreSpec{
+			BackupName:       backupName,
+			NamespaceMapping: namespaceMapping,
+		},
+	}
+	return c.Create(context.Background(), restore)
+}
+
+// restorePhase waits for a Restore to reach one of the given terminal phases.
+func restorePhase(namespace, restoreName string, phases ...velero.RestorePhase) wait.ConditionFunc {
+	return func() (bool, error) {
+		c, err := client.New(config.GetConfigOrDie(), client.Options{})
+		if err != nil {
+			return false, err
+		}
+		velero.AddToScheme(c.Scheme())
+
+		restore := velero.Restore{}
+		err = c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: restoreName}, &restore)
+		if err != nil {
+			return false, err
+		}
+		for _, phase := range phases {
+			if restore.Status.Phase == phase {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// isRestoreCompleted waits until the named Restore has completed.
+func isRestoreCompleted(namespace, restoreName string) wait.ConditionFunc {
+	return restorePhase(namespace, restoreName, velero.RestorePhaseCompleted)
+}
@@ -0,0 +1,66 @@
+package e2e
+
+import (
+	"context"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// uploaderMatrixEntry pairs an uploader type with the BackupRepository type
+// the operator is expected to create for it.
+type uploaderMatrixEntry struct {
+	UploaderType   oadpv1alpha1.UploaderType
+	BackupRepoType string
+}
+
+// uploaderMatrix drives pod-volume backup/restore against each supported
+// uploader so both code paths are exercised against the same object store.
+var uploaderMatrix = []uploaderMatrixEntry{
+	{UploaderType: oadpv1alpha1.UploaderTypeRestic, BackupRepoType: velero.BackupRepositoryTypeRestic},
+	{UploaderType: oadpv1alpha1.UploaderTypeKopia, BackupRepoType: velero.BackupRepositoryTypeKopia},
+}
+
+// getBackupRepositories returns the BackupRepository objects Velero has
+// created in the given namespace.
+func getBackupRepositories(namespace string) (*velero.BackupRepositoryList, error) {
+	c, err := client.New(config.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	velero.AddToScheme(c.Scheme())
+
+	repoList := velero.BackupRepositoryList{}
+	err = c.List(context.Background(), &repoList, client.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	return &repoList, nil
+}
+
+// backupRepositoryHasType waits until a BackupRepository in the given
+// namespace exists with the expected repository type.
+func backupRepositoryHasType(namespace, repoType string) wait.ConditionFunc {
+	return func() (bool, error) {
+		repoList, err := getBackupRepositories(namespace)
+		if err != nil {
+			return false, err
+		}
+		for _, repo := range repoList.Items {
+			if repo.Spec.RepositoryType == repoType {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// WithUploaderType sets the pod-volume uploader (restic or kopia) to use
+// when the Velero CR is built.
+func (v *veleroCustomResource) WithUploaderType(uploaderType oadpv1alpha1.UploaderType) *veleroCustomResource {
+	v.UploaderType = uploaderType
+	return v
+}
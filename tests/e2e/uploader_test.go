@@ -0,0 +1,102 @@
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/oadp-operator/tests/e2e/util/k8s"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// TestPodVolumeBackupRestoreAcrossUploaders drives the uploaderMatrix: for
+// each supported uploader it installs a Velero instance against the same
+// object store, confirms the operator created a BackupRepository of the
+// matching type, runs a pod-volume backup to completion, and restores it
+// into a new namespace to confirm the file contents come back intact.
+func TestPodVolumeBackupRestoreAcrossUploaders(t *testing.T) {
+	if testing.Short() {
+		t.Skip("requires a live cluster with Velero/OADP installed")
+	}
+
+	for _, entry := range uploaderMatrix {
+		entry := entry
+		t.Run(string(entry.UploaderType), func(t *testing.T) {
+			vcr := &veleroCustomResource{
+				Name:      fmt.Sprintf("velero-%s", entry.UploaderType),
+				Namespace: e2eNamespace,
+				Provider:  "aws",
+				Region:    "us-east-1",
+				Bucket:    e2eBucket,
+			}
+			vcr.WithUploaderType(entry.UploaderType)
+			if err := vcr.Build(); err != nil {
+				t.Fatalf("Build() failed: %v", err)
+			}
+			if err := vcr.Create(); err != nil {
+				t.Fatalf("Create() failed: %v", err)
+			}
+			t.Cleanup(func() {
+				if err := vcr.Delete(); err != nil {
+					t.Logf("cleanup: failed to delete Velero CR %s: %v", vcr.Name, err)
+				}
+			})
+
+			if err := wait.PollImmediate(5*time.Second, 5*time.Minute, isVeleroPodRunning(e2eNamespace)); err != nil {
+				t.Fatalf("velero pod never became ready for uploader %s: %v", entry.UploaderType, err)
+			}
+
+			if err := wait.PollImmediate(5*time.Second, 2*time.Minute, backupRepositoryHasType(e2eNamespace, entry.BackupRepoType)); err != nil {
+				t.Fatalf("no BackupRepository of type %s was created for uploader %s: %v", entry.BackupRepoType, entry.UploaderType, err)
+			}
+
+			c, err := client.New(config.GetConfigOrDie(), client.Options{})
+			if err != nil {
+				t.Fatalf("failed to build client: %v", err)
+			}
+			podName := fmt.Sprintf("pod-volume-%s", entry.UploaderType)
+			pvcName := podName + "-data"
+			if _, err := k8s.CreatePVC(c, workloadNamespace, pvcName, "csi-storage-class", "1Gi"); err != nil {
+				t.Fatalf("failed to create PVC %s: %v", pvcName, err)
+			}
+			if _, err := k8s.CreatePodWithVolumeAnnotation(c, workloadNamespace, podName, map[string]string{"data": pvcName}, nil, false); err != nil {
+				t.Fatalf("failed to create pod %s: %v", podName, err)
+			}
+			if err := wait.PollImmediate(5*time.Second, 2*time.Minute, k8s.IsPodRunning(c, workloadNamespace, podName)); err != nil {
+				t.Fatalf("pod %s never became ready: %v", podName, err)
+			}
+			restConfig := config.GetConfigOrDie()
+			if _, err := k8s.ExecInPod(restConfig, workloadNamespace, podName, []string{"sh", "-c", fmt.Sprintf("echo -n %s > /data/data/file.txt", fileContent)}); err != nil {
+				t.Fatalf("failed to write data into volume: %v", err)
+			}
+
+			backupName := fmt.Sprintf("pod-volume-backup-%s", entry.UploaderType)
+			if err := createBackupForBSL(e2eNamespace, backupName, "default", []string{workloadNamespace}); err != nil {
+				t.Fatalf("failed to create backup for uploader %s: %v", entry.UploaderType, err)
+			}
+			if err := wait.PollImmediate(10*time.Second, 10*time.Minute, isBackupCompleted(e2eNamespace, backupName)); err != nil {
+				t.Fatalf("pod-volume backup did not complete for uploader %s: %v", entry.UploaderType, err)
+			}
+
+			restoreNamespace := fmt.Sprintf("%s-restore-%s", workloadNamespace, entry.UploaderType)
+			restoreName := "restore-" + backupName
+			if err := createRestore(e2eNamespace, restoreName, backupName, map[string]string{workloadNamespace: restoreNamespace}); err != nil {
+				t.Fatalf("failed to create restore for uploader %s: %v", entry.UploaderType, err)
+			}
+			if err := wait.PollImmediate(10*time.Second, 10*time.Minute, isRestoreCompleted(e2eNamespace, restoreName)); err != nil {
+				t.Fatalf("restore did not complete for uploader %s: %v", entry.UploaderType, err)
+			}
+			if err := wait.PollImmediate(5*time.Second, 2*time.Minute, k8s.IsPodRunning(c, restoreNamespace, podName)); err != nil {
+				t.Fatalf("restored pod %s never became ready: %v", podName, err)
+			}
+
+			out, err := k8s.ExecInPod(restConfig, restoreNamespace, podName, []string{"cat", "/data/data/file.txt"})
+			if err != nil || strings.TrimSpace(out) != fileContent {
+				t.Fatalf("restored file contents for uploader %s = %q, err = %v; want %q", entry.UploaderType, out, err, fileContent)
+			}
+		})
+	}
+}
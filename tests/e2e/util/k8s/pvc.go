@@ -0,0 +1,46 @@
+package k8s
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CreatePVC creates a PersistentVolumeClaim bound to the given
+// StorageClass, sized for pod-volume backup/restore fixtures.
+func CreatePVC(c client.Client, namespace, name, storageClassName, size string) (*corev1.PersistentVolumeClaim, error) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: &storageClassName,
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(size),
+				},
+			},
+		},
+	}
+	return pvc, c.Create(context.Background(), pvc)
+}
+
+// GetPVC returns the named PersistentVolumeClaim in namespace.
+func GetPVC(c client.Client, namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, pvc)
+	if err != nil {
+		return nil, err
+	}
+	return pvc, nil
+}
+
+// IsPVCBound reports whether the PVC has reached the Bound phase.
+func IsPVCBound(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvc.Status.Phase == corev1.ClaimBound
+}
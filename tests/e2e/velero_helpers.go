@@ -4,9 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
-	"fmt"
 	"io"
-	"log"
 	"strings"
 
 	appsv1 "github.com/openshift/api/apps/v1"
@@ -24,48 +22,79 @@ import (
 )
 
 type veleroCustomResource struct {
-	Name           string
-	Namespace      string
-	SecretName     string
-	Bucket         string
-	Region         string
-	Provider       string
-	CustomResource *oadpv1alpha1.Velero
-	Client         client.Client
+	Name              string
+	Namespace         string
+	SecretName        string
+	Bucket            string
+	Region            string
+	Provider          string
+	UploaderType      oadpv1alpha1.UploaderType
+	MaintenanceConfig *oadpv1alpha1.MaintenanceConfig
+	// BSLConfigs optionally installs one or more provider-specific
+	// BackupStorageLocations. When unset, Build falls back to the single
+	// AWS BSL described by Provider/Region/Bucket for backward compatibility.
+	BSLConfigs          []BSLConfig
+	EnableCSI           bool
+	PodVolumeBackupMode oadpv1alpha1.PodVolumeBackupMode
+	CustomResource      *oadpv1alpha1.Velero
+	Client              client.Client
 }
 
 var veleroPrefix = "velero-e2e-" + string(uuid.NewUUID())
 
 func (v *veleroCustomResource) Build() error {
-	// Velero Instance creation spec with backupstorage location default to AWS. Would need to parameterize this later on to support multiple plugins.
+	uploaderType := v.UploaderType
+	if uploaderType == "" {
+		uploaderType = oadpv1alpha1.UploaderTypeRestic
+	}
+
+	podVolumeBackupMode := v.PodVolumeBackupMode
+	if podVolumeBackupMode == "" {
+		podVolumeBackupMode = oadpv1alpha1.PodVolumeBackupModeOptIn
+	}
+
+	bsls := v.BSLConfigs
+	if len(bsls) == 0 {
+		// Backward-compatible single AWS BSL built from Provider/Region/Bucket.
+		bsls = []BSLConfig{
+			{
+				Provider: v.Provider,
+				Region:   v.Region,
+				Bucket:   v.Bucket,
+				Prefix:   veleroPrefix,
+				Default:  true,
+			},
+		}
+	}
+	backupStorageLocations := make([]velero.BackupStorageLocationSpec, 0, len(bsls))
+	for _, bsl := range bsls {
+		backupStorageLocations = append(backupStorageLocations, bsl.toBackupStorageLocationSpec())
+	}
+
+	plugins := pluginsForBSLConfigs(bsls)
+	if v.EnableCSI {
+		plugins = append(plugins, oadpv1alpha1.DefaultPluginCSI)
+	}
+
 	veleroSpec := oadpv1alpha1.Velero{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      v.Name,
 			Namespace: v.Namespace,
 		},
 		Spec: oadpv1alpha1.VeleroSpec{
-			EnableRestic: pointer.Bool(true),
-			BackupStorageLocations: []velero.BackupStorageLocationSpec{
-				{
-					Provider: v.Provider,
-					Config: map[string]string{
-						"region": v.Region,
-					},
-					Default: true,
-					StorageType: velero.StorageType{
-						ObjectStorage: &velero.ObjectStorageLocation{
-							Bucket: v.Bucket,
-							Prefix: veleroPrefix,
-						},
-					},
-				},
-			},
-			DefaultVeleroPlugins: []oadpv1alpha1.DefaultPlugin{
-				oadpv1alpha1.DefaultPluginOpenShift,
-				oadpv1alpha1.DefaultPluginAWS,
-			},
+			EnableRestic:           pointer.Bool(true),
+			UploaderType:           uploaderType,
+			BackupStorageLocations: backupStorageLocations,
+			DefaultVeleroPlugins:   plugins,
+			PodVolumeBackupMode:    podVolumeBackupMode,
 		},
 	}
+	if v.EnableCSI {
+		veleroSpec.Spec.EnableCSI = pointer.Bool(true)
+	}
+	if v.MaintenanceConfig != nil {
+		veleroSpec.Spec.MaintenanceConfig = *v.MaintenanceConfig
+	}
 	v.CustomResource = &veleroSpec
 	return nil
 }
@@ -213,22 +242,6 @@ func getVeleroContainerLogs(namespace string) (string, error) {
 	return logs, nil
 }
 
-func getVeleroContainerFailureLogs(namespace string) []string {
-	containerLogs, err := getVeleroContainerLogs(namespace)
-	if err != nil {
-		log.Printf("cannot get velero container logs")
-		return nil
-	}
-	containerLogsArray := strings.Split(containerLogs,"\n")
-	var failureArr = []string{}
-	for i, line := range containerLogsArray {
-		if strings.Contains(line, "level=error") {
-			failureArr = append(failureArr, fmt.Sprintf("velero container error line#%d: " + line + "\n", i))
-		}
-	}
-	return failureArr
-}
-
 func (v *veleroCustomResource) IsDeleted() wait.ConditionFunc {
 	return func() (bool, error) {
 		err := v.SetClient()